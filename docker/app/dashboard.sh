@@ -1,3 +1,5 @@
 #!/bin/sh
 
-/app/bin/storagenode dashboard --config-dir /app/config --identity-dir /app/identity $@
\ No newline at end of file
+. /usr/lib/storagenode/identity
+
+/app/bin/storagenode dashboard --config-dir /app/config ${IDENTITY_PARAMS} $@
\ No newline at end of file
@@ -0,0 +1,5 @@
+#!/bin/sh
+
+. /usr/lib/storagenode/identity
+
+/app/bin/storagenode exit-status --config-dir /app/config ${IDENTITY_PARAMS} $@
\ No newline at end of file